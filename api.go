@@ -0,0 +1,435 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	BASE_URL    = "https://api.github.com"
+	GRAPHQL_URL = "https://api.github.com/graphql"
+	STAR_URL    = "https://api.github-star-counter.workers.dev"
+)
+
+var excludedLanguages = map[string]bool{
+	"HTML": true, "Jupyter Notebook": true, "Brainfuck": true,
+}
+
+// GitHub API response structures
+type GitHubUser struct {
+	Login       string    `json:"login"`
+	Followers   int       `json:"followers"`
+	Following   int       `json:"following"`
+	Bio         string    `json:"bio"`
+	PublicRepos int       `json:"public_repos"`
+	AvatarURL   string    `json:"avatar_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type GitHubRepo struct {
+	Name       string `json:"name"`
+	Language   string `json:"language"`
+	CommitsURL string `json:"commits_url"`
+	Fork       bool   `json:"fork"`
+	Archived   bool   `json:"archived"`
+}
+
+type StarResponse struct {
+	Stars int `json:"stars"`
+}
+
+type GitHubStats struct {
+	User              *GitHubUser
+	Repos             []GitHubRepo
+	Stars             int
+	TotalCommits      int
+	LanguageCount     map[string]int64
+	MostUsedLanguages string
+}
+
+// APIManager handles all GitHub API interactions
+type APIManager struct {
+	client *http.Client
+	token  string
+	cfg    *Config
+	stats  *GitHubStats
+}
+
+// NewAPIManager builds an APIManager for cfg. A nil client gets the
+// production client (authenticated, rate-limit aware); tests pass their
+// own client pointed at a mock transport instead.
+func NewAPIManager(cfg *Config, client *http.Client) *APIManager {
+	token := os.Getenv("GITHUB_TOKEN")
+	if client == nil {
+		client = &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: newGitHubTransport(token),
+		}
+	}
+	return &APIManager{
+		client: client,
+		token:  token,
+		cfg:    cfg,
+		stats: &GitHubStats{
+			LanguageCount: make(map[string]int64),
+		},
+	}
+}
+
+func (api *APIManager) fetchJSON(url string, target interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request for %s: %w", url, err)
+	}
+
+	resp, err := api.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status: %d for URL: %s", resp.StatusCode, url)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return json.Unmarshal(body, target)
+}
+
+func (api *APIManager) fetchUserData() error {
+	url := fmt.Sprintf("%s/users/%s", BASE_URL, api.cfg.User.Username)
+	api.stats.User = &GitHubUser{}
+	return api.fetchJSON(url, api.stats.User)
+}
+
+func (api *APIManager) fetchStarCount() error {
+	url := fmt.Sprintf("%s/user/%s", STAR_URL, api.cfg.User.Username)
+	starResp := &StarResponse{}
+	err := api.fetchJSON(url, starResp)
+	if err != nil {
+		return err
+	}
+	api.stats.Stars = starResp.Stars
+	return nil
+}
+
+func (api *APIManager) fetchRepos() error {
+	url := fmt.Sprintf("%s/users/%s/repos?per_page=100", BASE_URL, api.cfg.User.Username)
+	return api.fetchJSON(url, &api.stats.Repos)
+}
+
+// countCommits populates TotalCommits and LanguageCount. It prefers the
+// GraphQL contributions API, which reports the user's full commit history
+// instead of only the first page of each repo's commit list, falling back
+// to the REST walk below when GITHUB_TOKEN is unset (the GraphQL API
+// requires authentication).
+func (api *APIManager) countCommits() error {
+	if api.token != "" {
+		if err := api.countCommitsGraphQL(); err != nil {
+			return err
+		}
+		return api.countLanguagesGraphQL()
+	}
+
+	log.Println("GITHUB_TOKEN not set, falling back to REST commit counting")
+	return api.countCommitsREST()
+}
+
+// countCommitsREST walks every repo and fetches only the first page of
+// commits, undercounting prolific contributors. It exists solely as a
+// fallback for unauthenticated runs, which can't use the GraphQL API.
+func (api *APIManager) countCommitsREST() error {
+	totalCommits := 0
+
+	for _, repo := range api.stats.Repos {
+		if repo.Fork || repo.Archived {
+			continue
+		}
+
+		if repo.Language != "" && !excludedLanguages[repo.Language] {
+			api.stats.LanguageCount[repo.Language]++
+		}
+
+		commitsURL := strings.Replace(repo.CommitsURL, "{/sha}", "", 1)
+		commitsURL += "?per_page=100"
+
+		var commits []map[string]interface{}
+		if err := api.fetchJSON(commitsURL, &commits); err != nil {
+			log.Printf("Warning: Could not fetch commits for repo %s: %v", repo.Name, err)
+			continue
+		}
+		totalCommits += len(commits)
+	}
+
+	api.stats.TotalCommits = totalCommits
+	api.generateMostUsedLanguages()
+	return nil
+}
+
+func (api *APIManager) generateMostUsedLanguages() {
+	type langCount struct {
+		lang  string
+		count int64
+	}
+
+	var langCounts []langCount
+	for lang, count := range api.stats.LanguageCount {
+		langCounts = append(langCounts, langCount{lang, count})
+	}
+
+	sort.Slice(langCounts, func(i, j int) bool {
+		return langCounts[i].count > langCounts[j].count
+	})
+
+	var result strings.Builder
+	totalLen := 0
+
+	for i, lc := range langCounts {
+		langLen := len(lc.lang)
+		if i > 0 {
+			langLen += 2
+		}
+
+		if totalLen+langLen > api.cfg.User.MaxLangLen {
+			break
+		}
+
+		if i > 0 {
+			result.WriteString(", ")
+		}
+		result.WriteString(lc.lang)
+		totalLen += langLen
+	}
+
+	api.stats.MostUsedLanguages = result.String()
+}
+
+func (api *APIManager) Setup() error {
+	log.Println("Fetching user data...")
+	if err := api.fetchUserData(); err != nil {
+		return fmt.Errorf("failed to fetch user data: %w", err)
+	}
+
+	log.Println("Fetching star count...")
+	if err := api.fetchStarCount(); err != nil {
+		log.Printf("Warning: failed to fetch star count, continuing with 0: %v", err)
+	}
+
+	log.Println("Fetching repositories...")
+	if err := api.fetchRepos(); err != nil {
+		return fmt.Errorf("failed to fetch repos: %w", err)
+	}
+
+	log.Println("Counting commits and analyzing languages...")
+	if err := api.countCommits(); err != nil {
+		return fmt.Errorf("failed to count commits: %w", err)
+	}
+
+	return nil
+}
+
+func (api *APIManager) GetBio() string {
+	bio := api.stats.User.Bio
+	if bio == "" {
+		bio = "New user"
+	}
+	if len(bio) > api.cfg.User.MaxBioLen {
+		return bio[:api.cfg.User.MaxBioLen] + "..."
+	}
+	return bio
+}
+
+// graphQLRequest executes a single GraphQL POST against BASE_URL/graphql,
+// authenticating with the GITHUB_TOKEN the caller already verified is set.
+func (api *APIManager) graphQLRequest(query string, variables map[string]interface{}, target interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal GraphQL request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, GRAPHQL_URL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build GraphQL request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := api.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute GraphQL request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GraphQL request failed with status: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read GraphQL response: %w", err)
+	}
+
+	var envelope struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && len(envelope.Errors) > 0 {
+		return fmt.Errorf("GraphQL error: %s", envelope.Errors[0].Message)
+	}
+
+	return json.Unmarshal(body, target)
+}
+
+const contributionsQuery = `
+query($login: String!, $from: DateTime!, $to: DateTime!) {
+  user(login: $login) {
+    contributionsCollection(from: $from, to: $to) {
+      totalCommitContributions
+      restrictedContributionsCount
+    }
+  }
+}`
+
+// countCommitsGraphQL sums commit contributions year-by-year since GitHub
+// caps each contributionsCollection range to one year, walking back from
+// today to the user's account creation date.
+func (api *APIManager) countCommitsGraphQL() error {
+	from := api.stats.User.CreatedAt
+	if from.IsZero() {
+		return fmt.Errorf("user creation date unknown, cannot paginate contributions")
+	}
+	now := time.Now()
+
+	var total int64
+	for from.Before(now) {
+		to := from.AddDate(1, 0, 0)
+		if to.After(now) {
+			to = now
+		}
+
+		var resp struct {
+			Data struct {
+				User struct {
+					ContributionsCollection struct {
+						TotalCommitContributions     int64 `json:"totalCommitContributions"`
+						RestrictedContributionsCount int64 `json:"restrictedContributionsCount"`
+					} `json:"contributionsCollection"`
+				} `json:"user"`
+			} `json:"data"`
+		}
+
+		variables := map[string]interface{}{
+			"login": api.cfg.User.Username,
+			"from":  from.Format(time.RFC3339),
+			"to":    to.Format(time.RFC3339),
+		}
+		if err := api.graphQLRequest(contributionsQuery, variables, &resp); err != nil {
+			return fmt.Errorf("failed to fetch contributions from %s to %s: %w", from.Format("2006-01-02"), to.Format("2006-01-02"), err)
+		}
+
+		cc := resp.Data.User.ContributionsCollection
+		total += cc.TotalCommitContributions + cc.RestrictedContributionsCount
+		from = to
+	}
+
+	api.stats.TotalCommits = int(total)
+	return nil
+}
+
+const languagesQuery = `
+query($login: String!, $cursor: String) {
+  user(login: $login) {
+    repositories(ownerAffiliations: OWNER, isFork: false, isArchived: false, first: 100, after: $cursor) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      edges {
+        node {
+          languages(first: 100) {
+            edges {
+              size
+              node {
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// countLanguagesGraphQL replaces the REST "primary language per repo" tally
+// with byte-weighted counts across every language used in each repo.
+func (api *APIManager) countLanguagesGraphQL() error {
+	var cursor interface{}
+
+	for {
+		var resp struct {
+			Data struct {
+				User struct {
+					Repositories struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Edges []struct {
+							Node struct {
+								Languages struct {
+									Edges []struct {
+										Size int64 `json:"size"`
+										Node struct {
+											Name string `json:"name"`
+										} `json:"node"`
+									} `json:"edges"`
+								} `json:"languages"`
+							} `json:"node"`
+						} `json:"edges"`
+					} `json:"repositories"`
+				} `json:"user"`
+			} `json:"data"`
+		}
+
+		variables := map[string]interface{}{
+			"login":  api.cfg.User.Username,
+			"cursor": cursor,
+		}
+		if err := api.graphQLRequest(languagesQuery, variables, &resp); err != nil {
+			return fmt.Errorf("failed to fetch repository languages: %w", err)
+		}
+
+		repos := resp.Data.User.Repositories
+		for _, edge := range repos.Edges {
+			for _, langEdge := range edge.Node.Languages.Edges {
+				if excludedLanguages[langEdge.Node.Name] {
+					continue
+				}
+				api.stats.LanguageCount[langEdge.Node.Name] += langEdge.Size
+			}
+		}
+
+		if !repos.PageInfo.HasNextPage {
+			break
+		}
+		cursor = repos.PageInfo.EndCursor
+	}
+
+	api.generateMostUsedLanguages()
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func testConfig() *Config {
+	cfg := DefaultConfig()
+	cfg.User.Username = "shv-ng"
+	cfg.User.MaxBioLen = 10
+	cfg.User.MaxLangLen = 12
+	return cfg
+}
+
+func newTestAPIManager(cfg *Config) *APIManager {
+	client := &http.Client{}
+	gock.InterceptClient(client)
+	return NewAPIManager(cfg, client)
+}
+
+func TestGetBio_EmptyBioFallsBackToDefault(t *testing.T) {
+	api := newTestAPIManager(testConfig())
+	api.stats.User = &GitHubUser{Bio: ""}
+
+	if got := api.GetBio(); got != "New user" {
+		t.Errorf("GetBio() = %q, want %q", got, "New user")
+	}
+}
+
+func TestGetBio_TruncatesLongBio(t *testing.T) {
+	cfg := testConfig()
+	api := newTestAPIManager(cfg)
+	api.stats.User = &GitHubUser{Bio: "this bio is definitely longer than the limit"}
+
+	want := "this bio i..."
+	if got := api.GetBio(); got != want {
+		t.Errorf("GetBio() = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateMostUsedLanguages_TruncatesAtMaxLangLen(t *testing.T) {
+	cfg := testConfig()
+	api := newTestAPIManager(cfg)
+	api.stats.LanguageCount = map[string]int64{
+		"Go":         100,
+		"Python":     50,
+		"JavaScript": 10,
+	}
+
+	api.generateMostUsedLanguages()
+
+	want := "Go, Python"
+	if got := api.stats.MostUsedLanguages; got != want {
+		t.Errorf("MostUsedLanguages = %q, want %q", got, want)
+	}
+}
+
+func TestCountCommitsREST_ExcludesForksArchivedAndLanguages(t *testing.T) {
+	defer gock.Off()
+
+	cfg := testConfig()
+	api := newTestAPIManager(cfg)
+	api.stats.Repos = []GitHubRepo{
+		{Name: "real-repo", Language: "Go", CommitsURL: BASE_URL + "/repos/shv-ng/real-repo/commits{/sha}"},
+		{Name: "forked-repo", Language: "Go", Fork: true, CommitsURL: BASE_URL + "/repos/shv-ng/forked-repo/commits{/sha}"},
+		{Name: "archived-repo", Language: "Go", Archived: true, CommitsURL: BASE_URL + "/repos/shv-ng/archived-repo/commits{/sha}"},
+		{Name: "docs-repo", Language: "HTML", CommitsURL: BASE_URL + "/repos/shv-ng/docs-repo/commits{/sha}"},
+	}
+
+	gock.New(BASE_URL).
+		Get("/repos/shv-ng/real-repo/commits").
+		Reply(200).
+		JSON([]map[string]interface{}{{"sha": "a"}, {"sha": "b"}})
+
+	if err := api.countCommitsREST(); err != nil {
+		t.Fatalf("countCommitsREST() error = %v", err)
+	}
+
+	if api.stats.TotalCommits != 2 {
+		t.Errorf("TotalCommits = %d, want 2", api.stats.TotalCommits)
+	}
+	if _, ok := api.stats.LanguageCount["HTML"]; ok {
+		t.Errorf("LanguageCount should exclude HTML, got %v", api.stats.LanguageCount)
+	}
+	if count := api.stats.LanguageCount["Go"]; count != 1 {
+		t.Errorf("LanguageCount[Go] = %d, want 1 (fork/archived excluded)", count)
+	}
+}
+
+func TestSetup_StarEndpointFailureIsNotFatal(t *testing.T) {
+	defer gock.Off()
+
+	cfg := testConfig()
+	api := newTestAPIManager(cfg)
+
+	gock.New(BASE_URL).
+		Get("/users/shv-ng").
+		Reply(200).
+		JSON(GitHubUser{Login: "shv-ng", Bio: "hi"})
+
+	gock.New(STAR_URL).
+		Get("/user/shv-ng").
+		Reply(500)
+
+	gock.New(BASE_URL).
+		Get("/users/shv-ng/repos").
+		Reply(200).
+		JSON([]GitHubRepo{})
+
+	if err := api.Setup(); err != nil {
+		t.Fatalf("Setup() error = %v, want nil (star failure should not be fatal)", err)
+	}
+
+	if api.stats.Stars != 0 {
+		t.Errorf("Stars = %d, want 0 after failed fetch", api.stats.Stars)
+	}
+}
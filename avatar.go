@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/nfnt/resize"
+)
+
+// avatarCacheDir returns ~/.cache/shv-ng, creating it if necessary.
+func avatarCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+
+	dir := filepath.Join(home, ".cache", "shv-ng")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cache dir %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+// fetchAvatarBase64 downloads the user's avatar, downscales it to
+// size×size with Lanczos3, and returns it base64-encoded for inline use
+// in a data: URI. Resized output is cached under
+// ~/.cache/shv-ng/avatar-<etag>.png, keyed off the avatar's ETag, so
+// repeated runs against an unchanged avatar skip the decode/resize work.
+func fetchAvatarBase64(client *http.Client, avatarURL string, size int) (string, error) {
+	cacheDir, err := avatarCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Get(avatarURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch avatar: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("avatar request failed with status: %d", resp.StatusCode)
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		etag = "no-etag"
+	}
+	cachePath := filepath.Join(cacheDir, fmt.Sprintf("avatar-%s.png", sanitizeETag(etag)))
+
+	if cached, err := os.ReadFile(cachePath); err == nil {
+		return base64.StdEncoding.EncodeToString(cached), nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read avatar response: %w", err)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode avatar image: %w", err)
+	}
+
+	resized := resize.Resize(uint(size), uint(size), img, resize.Lanczos3)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, resized); err != nil {
+		return "", fmt.Errorf("failed to encode resized avatar: %w", err)
+	}
+
+	if err := os.WriteFile(cachePath, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("failed to write avatar cache %s: %w", cachePath, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// sanitizeETag strips the quoting GitHub wraps ETags in so the value is
+// safe to use as a filename.
+func sanitizeETag(etag string) string {
+	out := make([]rune, 0, len(etag))
+	for _, r := range etag {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			out = append(out, r)
+		}
+	}
+	if len(out) == 0 {
+		return "no-etag"
+	}
+	return string(out)
+}
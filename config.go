@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds everything that used to be hard-coded in main.go: the
+// target user and output file, the SVG color palette, and the panel
+// layout. A missing config.yaml is not an error — DefaultConfig()
+// reproduces the previous built-in look exactly.
+type Config struct {
+	User   UserConfig   `yaml:"user"`
+	Output OutputConfig `yaml:"output"`
+	Theme  ThemeConfig  `yaml:"theme"`
+	Layout LayoutConfig `yaml:"layout"`
+	Art    ArtConfig    `yaml:"art"`
+	Avatar AvatarConfig `yaml:"avatar"`
+}
+
+type UserConfig struct {
+	Username   string `yaml:"username"`
+	MaxBioLen  int    `yaml:"max_bio_len"`
+	MaxLangLen int    `yaml:"max_lang_len"`
+}
+
+type OutputConfig struct {
+	FileName string `yaml:"file_name"`
+}
+
+// ThemeConfig maps an SVG element id or class to its fill color. Name is
+// only set when the theme was looked up from the built-in registry by
+// name (see themes.go); inline fills in config.yaml leave it empty.
+type ThemeConfig struct {
+	Name  string            `yaml:"name"`
+	Fills map[string]string `yaml:"fills"`
+}
+
+// LayoutConfig describes the overall canvas plus the position and size
+// of each named panel (art, profile, and so on).
+type LayoutConfig struct {
+	Width   string                 `yaml:"width"`
+	Height  string                 `yaml:"height"`
+	ViewBox string                 `yaml:"view_box"`
+	Panels  map[string]PanelConfig `yaml:"panels"`
+}
+
+type PanelConfig struct {
+	X      string `yaml:"x"`
+	Y      string `yaml:"y"`
+	Width  string `yaml:"width"`
+	Height string `yaml:"height"`
+}
+
+// ArtConfig points at a UTF-8 text file holding the ASCII art panel
+// content. An empty Path keeps the built-in art baked into svg_art.go.
+// Enabled defaults to true so existing configs keep rendering the logo.
+type ArtConfig struct {
+	Enabled *bool  `yaml:"enabled"`
+	Path    string `yaml:"path"`
+}
+
+func (a ArtConfig) enabled() bool {
+	return a.Enabled == nil || *a.Enabled
+}
+
+// AvatarConfig controls the embedded GitHub avatar panel. It's disabled
+// by default so existing configs don't suddenly start making an extra
+// HTTP request; Size is the square thumbnail's side length in pixels.
+type AvatarConfig struct {
+	Enabled bool `yaml:"enabled"`
+	Size    int  `yaml:"size"`
+}
+
+// DefaultConfig reproduces the behavior of the tool before config.yaml
+// existed, so running without a config file (or flag) changes nothing.
+func DefaultConfig() *Config {
+	return &Config{
+		User: UserConfig{
+			Username:   "shv-ng",
+			MaxBioLen:  45,
+			MaxLangLen: 35,
+		},
+		Output: OutputConfig{
+			FileName: "terminal.svg",
+		},
+		Theme: cloneTheme(builtinThemes["catppuccin-mocha"]),
+		Layout: LayoutConfig{
+			Width:   "1040",
+			Height:  "660",
+			ViewBox: "0 0 1020 650",
+			Panels: map[string]PanelConfig{
+				"bg":      {X: "10", Y: "10", Width: "1000", Height: "620"},
+				"art":     {X: "30", Y: "220"},
+				"profile": {X: "400", Y: "220"},
+				"avatar":  {X: "30", Y: "220", Width: "96", Height: "96"},
+			},
+		},
+		Art: ArtConfig{
+			Enabled: boolPtr(true),
+		},
+		Avatar: AvatarConfig{
+			Enabled: false,
+			Size:    96,
+		},
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// LoadConfig reads and parses a config.yaml. Any field the file omits
+// keeps its DefaultConfig() value. A theme.name that matches a built-in
+// (see themes.go) resolves to that palette; any fills given alongside it
+// are layered on top, so a config can select "dracula" and still tweak
+// individual colors.
+func LoadConfig(path string) (*Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	if cfg.Theme.Name != "" {
+		if _, ok := builtinThemes[cfg.Theme.Name]; ok {
+			var overrides struct {
+				Theme struct {
+					Fills map[string]string `yaml:"fills"`
+				} `yaml:"theme"`
+			}
+			if err := yaml.Unmarshal(data, &overrides); err != nil {
+				return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+			}
+
+			if err := cfg.ApplyTheme(cfg.Theme.Name); err != nil {
+				return nil, err
+			}
+			for id, fill := range overrides.Theme.Fills {
+				cfg.Theme.Fills[id] = fill
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// ApplyTheme overrides cfg.Theme with a built-in theme looked up by name,
+// used to back the -theme flag.
+func (cfg *Config) ApplyTheme(name string) error {
+	theme, ok := builtinThemes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme: %s", name)
+	}
+	cfg.Theme = cloneTheme(theme)
+	return nil
+}
+
+// cloneTheme copies a ThemeConfig's Fills map so callers can safely
+// mutate it without corrupting the shared builtinThemes registry.
+func cloneTheme(theme ThemeConfig) ThemeConfig {
+	fills := make(map[string]string, len(theme.Fills))
+	for id, fill := range theme.Fills {
+		fills[id] = fill
+	}
+	return ThemeConfig{Name: theme.Name, Fills: fills}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig_ThemeNameResolvesBuiltinFills(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("theme:\n  name: dracula\n"), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	want := builtinThemes["dracula"].Fills
+	for id, fill := range want {
+		if got := cfg.Theme.Fills[id]; got != fill {
+			t.Errorf("Theme.Fills[%q] = %q, want %q", id, got, fill)
+		}
+	}
+}
+
+func TestLoadConfig_ThemeNameWithInlineFillsOverridesOnTop(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := "theme:\n  name: dracula\n  fills:\n    bg: \"#000000\"\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if got := cfg.Theme.Fills["bg"]; got != "#000000" {
+		t.Errorf("Theme.Fills[bg] = %q, want %q (inline override)", got, "#000000")
+	}
+	if got, want := cfg.Theme.Fills["text"], builtinThemes["dracula"].Fills["text"]; got != want {
+		t.Errorf("Theme.Fills[text] = %q, want %q (untouched dracula fill)", got, want)
+	}
+
+	// ApplyTheme must not have mutated the shared builtin registry.
+	if builtinThemes["dracula"].Fills["bg"] != "#282a36" {
+		t.Errorf("builtinThemes[dracula].Fills[bg] was mutated: got %q", builtinThemes["dracula"].Fills["bg"])
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const devFixturesDir = "testdata/dev"
+
+// loadDevFixtures populates api.stats from the JSON fixtures under
+// devFixturesDir instead of calling the GitHub API, so `serve -dev` can be
+// iterated on without burning rate limit budget.
+func loadDevFixtures(api *APIManager) error {
+	user := &GitHubUser{}
+	if err := readFixture("user.json", user); err != nil {
+		return err
+	}
+	api.stats.User = user
+
+	var repos []GitHubRepo
+	if err := readFixture("repos.json", &repos); err != nil {
+		return err
+	}
+	api.stats.Repos = repos
+
+	var star StarResponse
+	if err := readFixture("stars.json", &star); err != nil {
+		return err
+	}
+	api.stats.Stars = star.Stars
+
+	var commits struct {
+		TotalCommits int `json:"total_commits"`
+	}
+	if err := readFixture("commits.json", &commits); err != nil {
+		return err
+	}
+	api.stats.TotalCommits = commits.TotalCommits
+
+	for _, repo := range api.stats.Repos {
+		if repo.Fork || repo.Archived {
+			continue
+		}
+		if repo.Language != "" && !excludedLanguages[repo.Language] {
+			api.stats.LanguageCount[repo.Language]++
+		}
+	}
+	api.generateMostUsedLanguages()
+
+	return nil
+}
+
+func readFixture(name string, target interface{}) error {
+	path := filepath.Join(devFixturesDir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read fixture %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	return nil
+}
@@ -1,26 +1,15 @@
 package main
 
 import (
-	"encoding/json"
 	"encoding/xml"
+	"flag"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
-	"sort"
-	"strings"
 	"time"
 )
 
-const (
-	USERNAME     = "shv-ng"
-	BASE_URL     = "https://api.github.com"
-	STAR_URL     = "https://api.github-star-counter.workers.dev"
-	FILE_NAME    = "terminal.svg"
-	MAX_BIO_LEN  = 45
-	MAX_LANG_LEN = 35
-)
+const defaultConfigPath = "config.yaml"
 
 // SVG structure definitions
 type SVG struct {
@@ -32,9 +21,19 @@ type SVG struct {
 	PreserveAR string   `xml:"preserveAspectRatio,attr"`
 	Background Rect     `xml:"rect"`
 	Texts      []Text   `xml:"text"`
+	Images     []Image  `xml:"image,omitempty"`
 	Style      Style    `xml:"style"`
 }
 
+type Image struct {
+	ID     string `xml:"id,attr"`
+	X      string `xml:"x,attr"`
+	Y      string `xml:"y,attr"`
+	Width  string `xml:"width,attr"`
+	Height string `xml:"height,attr"`
+	Href   string `xml:"href,attr"`
+}
+
 type Rect struct {
 	ID     string `xml:"id,attr"`
 	Class  string `xml:"class,attr"`
@@ -67,220 +66,22 @@ type Style struct {
 	Value string `xml:",cdata"`
 }
 
-// GitHub API response structures
-type GitHubUser struct {
-	Login       string `json:"login"`
-	Followers   int    `json:"followers"`
-	Following   int    `json:"following"`
-	Bio         string `json:"bio"`
-	PublicRepos int    `json:"public_repos"`
-}
-
-type GitHubRepo struct {
-	Name       string `json:"name"`
-	Language   string `json:"language"`
-	CommitsURL string `json:"commits_url"`
-	Fork       bool   `json:"fork"`
-	Archived   bool   `json:"archived"`
-}
-
-type StarResponse struct {
-	Stars int `json:"stars"`
-}
-
-type GitHubStats struct {
-	User              *GitHubUser
-	Repos             []GitHubRepo
-	Stars             int
-	TotalCommits      int
-	LanguageCount     map[string]int
-	MostUsedLanguages string
-}
-
-// APIManager handles all GitHub API interactions
-type APIManager struct {
-	client *http.Client
-	stats  *GitHubStats
-}
-
-func NewAPIManager() *APIManager {
-	return &APIManager{
-		client: &http.Client{Timeout: 30 * time.Second},
-		stats: &GitHubStats{
-			LanguageCount: make(map[string]int),
-		},
-	}
-}
-
-func (api *APIManager) fetchJSON(url string, target interface{}) error {
-	resp, err := api.client.Get(url)
-	if err != nil {
-		return fmt.Errorf("failed to fetch %s: %w", url, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status: %d for URL: %s", resp.StatusCode, url)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	return json.Unmarshal(body, target)
-}
-
-func (api *APIManager) fetchUserData() error {
-	url := fmt.Sprintf("%s/users/%s", BASE_URL, USERNAME)
-	api.stats.User = &GitHubUser{}
-	return api.fetchJSON(url, api.stats.User)
-}
-
-func (api *APIManager) fetchStarCount() error {
-	url := fmt.Sprintf("%s/user/%s", STAR_URL, USERNAME)
-	starResp := &StarResponse{}
-	err := api.fetchJSON(url, starResp)
-	if err != nil {
-		return err
-	}
-	api.stats.Stars = starResp.Stars
-	return nil
-}
-
-func (api *APIManager) fetchRepos() error {
-	url := fmt.Sprintf("%s/users/%s/repos?per_page=100", BASE_URL, USERNAME)
-	return api.fetchJSON(url, &api.stats.Repos)
-}
-
-func (api *APIManager) countCommits() error {
-	totalCommits := 0
-	excludedLanguages := map[string]bool{
-		"HTML": true, "Jupyter Notebook": true, "Brainfuck": true,
-	}
-
-	for _, repo := range api.stats.Repos {
-		if repo.Fork || repo.Archived {
-			continue
-		}
-
-		if repo.Language != "" && !excludedLanguages[repo.Language] {
-			api.stats.LanguageCount[repo.Language]++
-		}
-
-		commitsURL := strings.Replace(repo.CommitsURL, "{/sha}", "", 1)
-		commitsURL += "?per_page=100"
-
-		var commits []map[string]interface{}
-		if err := api.fetchJSON(commitsURL, &commits); err != nil {
-			log.Printf("Warning: Could not fetch commits for repo %s: %v", repo.Name, err)
-			continue
-		}
-		totalCommits += len(commits)
-	}
-
-	api.stats.TotalCommits = totalCommits
-	api.generateMostUsedLanguages()
-	return nil
-}
-
-func (api *APIManager) generateMostUsedLanguages() {
-	type langCount struct {
-		lang  string
-		count int
-	}
-
-	var langCounts []langCount
-	for lang, count := range api.stats.LanguageCount {
-		langCounts = append(langCounts, langCount{lang, count})
-	}
-
-	sort.Slice(langCounts, func(i, j int) bool {
-		return langCounts[i].count > langCounts[j].count
-	})
-
-	var result strings.Builder
-	totalLen := 0
-
-	for i, lc := range langCounts {
-		langLen := len(lc.lang)
-		if i > 0 {
-			langLen += 2
-		}
-
-		if totalLen+langLen > MAX_LANG_LEN {
-			break
-		}
-
-		if i > 0 {
-			result.WriteString(", ")
-		}
-		result.WriteString(lc.lang)
-		totalLen += langLen
-	}
-
-	api.stats.MostUsedLanguages = result.String()
-}
-
-func (api *APIManager) Setup() error {
-	log.Println("Fetching user data...")
-	if err := api.fetchUserData(); err != nil {
-		return fmt.Errorf("failed to fetch user data: %w", err)
-	}
-
-	log.Println("Fetching star count...")
-	if err := api.fetchStarCount(); err != nil {
-		return fmt.Errorf("failed to fetch star count: %w", err)
-	}
-
-	log.Println("Fetching repositories...")
-	if err := api.fetchRepos(); err != nil {
-		return fmt.Errorf("failed to fetch repos: %w", err)
-	}
-
-	log.Println("Counting commits and analyzing languages...")
-	if err := api.countCommits(); err != nil {
-		return fmt.Errorf("failed to count commits: %w", err)
-	}
-
-	return nil
-}
-
-func (api *APIManager) GetBio() string {
-	bio := api.stats.User.Bio
-	if bio == "" {
-		bio = "New user"
-	}
-	if len(bio) > MAX_BIO_LEN {
-		return bio[:MAX_BIO_LEN] + "..."
-	}
-	return bio
-}
-
 // SVG Generator
 type SVGGenerator struct {
 	api *APIManager
+	cfg *Config
+	now func() time.Time
 }
 
-func NewSVGGenerator(api *APIManager) *SVGGenerator {
-	return &SVGGenerator{api: api}
+func NewSVGGenerator(api *APIManager, cfg *Config) *SVGGenerator {
+	return &SVGGenerator{api: api, cfg: cfg, now: time.Now}
 }
 
 func (sg *SVGGenerator) generateAsciiArt() []Tspan {
-	artLines := []string{
-		"⠀⠀⠀⠀⠀⠀⠀⢀⣠⣤⣤⣶⣶⣶⣶⣤⣤⣄⡀⠀⠀⠀⠀⠀⠀⠀",
-		"⠀⠀⠀⠀⢀⣤⣾⣿⣿⠿⠟⠛⠛⠛⠛⠻⠿⣿⣿⣷⣤⡀⠀⠀⠀⠀",
-		"⠀⠀⠀⣴⣿⣿⠟⠋⠁⠀⠀⠀⠀⠀⠀⠀⠀⠈⠙⠻⣿⣿⣦⠀⠀⠀",
-		"⠀⢀⣾⣿⡿⠁⠀⠀⣴⣦⣄⠀⠀⠀⠀⠀⣀⣤⣶⡀⠈⢿⣿⣷⡀⠀",
-		"⠀⣾⣿⡟⠁⠀⠀⠀⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⠃⠀⠈⢻⣿⣷⠀",
-		"⢠⣿⣿⠁⠀⠀⠀⣠⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣦⠀⠀⠈⣿⣿⡄",
-		"⢸⣿⣿⠀⠀⠀⢰⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⡇⠀⠀⣿⣿⡇",
-		"⠘⣿⣿⡦⠤⠒⠒⢿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⡿⠧⠤⢴⣿⣿⠃",
-		"⠀⢿⣿⣧⡀⠀⢤⡀⠙⠻⠿⣿⣿⣿⣿⣿⡿⠟⠋⠁⠀⢀⣼⣿⡿⠀",
-		"⠀⠈⢿⣿⣷⡀⠈⢿⣦⣤⣾⣿⣿⣿⣿⣿⣷⣄⠀⠀⢀⣾⣿⡿⠁⠀",
-		"⠀⠀⠀⠻⣿⣿⣦⣄⡉⣿⣿⢿⣿⠉⢻⣿⢿⣿⣠⣴⣿⣿⠟⠀⠀⠀",
-		"⠀⠀⠀⠀⠈⠛⢿⣿⣿⣿⣧⣼⣿⣤⣾⣷⣶⣿⣿⡿⠛⠁⠀⠀⠀⠀",
-		"⠀⠀⠀⠀⠀⠀⠀⠈⠙⠛⠛⠿⠿⠿⠿⠛⠛⠋⠁⠀⠀⠀⠀⠀⠀⠀",
+	artLines, err := loadAsciiArt(sg.cfg)
+	if err != nil {
+		log.Printf("Warning: Could not load art file, using built-in art: %v", err)
+		artLines = defaultAsciiArt
 	}
 
 	var tspans []Tspan
@@ -294,238 +95,287 @@ func (sg *SVGGenerator) generateAsciiArt() []Tspan {
 	return tspans
 }
 
-func (sg *SVGGenerator) Generate() *SVG {
-	currentTime := time.Now().Format("Mon Jan 02 15:04:05 2006 on tty1")
+// buildStyle renders the SVG <style> block from the active theme's fill
+// map, keeping the CSS structure fixed while swapping only the colors.
+func buildStyle(theme ThemeConfig) string {
+	fill := func(id string) string {
+		if color, ok := theme.Fills[id]; ok {
+			return color
+		}
+		return "#ffffff"
+	}
 
-	svg := &SVG{
-		Xmlns:      "http://www.w3.org/2000/svg",
-		Width:      "1040",
-		Height:     "660",
-		ViewBox:    "0 0 1020 650",
-		PreserveAR: "xMidYMid",
-		Background: Rect{
-			ID:     "bg-rect",
-			Class:  "bg",
-			Width:  "1000",
-			Height: "620",
-			RX:     "20",
-			RY:     "20",
-			X:      "10",
-			Y:      "10",
-		},
-		Texts: []Text{
-			{
-				ID:    "text-1",
-				Class: "text",
-				X:     "30",
-				Y:     "40",
-				Value: "Arch Linux 6.7.1-arch1-1 (tty1)",
-			},
-			{
-				ID:    "text-2",
-				Class: "text",
-				X:     "30",
-				Y:     "80",
-				Value: "github.com login: ",
-				Tspan: []Tspan{
-					{
-						ID:    "login-username",
-						Class: "login",
-						Value: USERNAME,
-					},
-				},
-			},
-			{
-				ID:    "text-3",
-				Class: "text",
-				X:     "30",
-				Y:     "110",
-				Value: "password: ",
-				Tspan: []Tspan{
-					{
-						ID:    "password",
-						Class: "password",
-						Value: "******",
-					},
-				},
-			},
-			{
-				ID:    "text-4",
-				Class: "text",
-				X:     "30",
-				Y:     "140",
-				Value: "Last login: ",
-				Tspan: []Tspan{
-					{
-						ID:    "last-login",
-						Class: "last-login",
-						Value: currentTime,
-					},
-				},
-			},
-			{
-				ID:    "text-5",
-				Class: "text",
-				X:     "30",
-				Y:     "190",
-				Value: "[" + USERNAME + "@github ~]$ ",
-				Tspan: []Tspan{
-					{
-						ID:    "whoami",
-						Class: "command",
-						Value: "./whoami.sh",
-					},
-				},
-			},
-			{
-				ID:    "art",
-				Class: "art",
-				X:     "30",
-				Y:     "220",
-				Tspan: sg.generateAsciiArt(),
-			},
-			{
-				ID:    "profile-info",
-				Class: "profile",
-				X:     "400",
-				Y:     "220",
-				Tspan: []Tspan{
-					{
-						ID:    "profile-username",
-						X:     "400",
-						DY:    "1.3em",
-						Value: USERNAME,
-					},
-					{
-						ID:    "profile-separator",
-						X:     "400",
-						DY:    "1.3em",
-						Value: "-----------------------",
-					},
-					{
-						ID:    "user-bio",
-						X:     "400",
-						DY:    "2.3em",
-						Value: "Bio: " + sg.api.GetBio(),
-					},
-					{
-						ID:    "followers",
-						X:     "400",
-						DY:    "1.3em",
-						Value: fmt.Sprintf("Followers: %d", sg.api.stats.User.Followers),
-					},
-					{
-						ID:    "profile-following",
-						X:     "400",
-						DY:    "1.3em",
-						Value: fmt.Sprintf("Following: %d", sg.api.stats.User.Following),
-					},
-					{
-						ID:    "total-repo",
-						X:     "400",
-						DY:    "2.3em",
-						Value: fmt.Sprintf("Total Repo: %d", sg.api.stats.User.PublicRepos),
-					},
-					{
-						ID:    "total-stars",
-						X:     "400",
-						DY:    "1.3em",
-						Value: fmt.Sprintf("Total Stars: %d", sg.api.stats.Stars),
-					},
-					{
-						ID:    "total-commits",
-						X:     "400",
-						DY:    "1.3em",
-						Value: fmt.Sprintf("Total Commits: %d", sg.api.stats.TotalCommits),
-					},
-					{
-						ID:    "most-used-language",
-						X:     "400",
-						DY:    "1.3em",
-						Value: "Most used language: " + sg.api.stats.MostUsedLanguages,
-					},
-				},
-			},
-			{
-				ID:    "reboot-message",
-				Class: "text",
-				X:     "30",
-				Y:     "550",
-				Value: "[" + USERNAME + "@github ~]$ ",
-				Tspan: []Tspan{
-					{
-						ID:    "reboot-command",
-						Class: "reboot-command",
-						Value: `echo "Reboot in 5 sec..." ; sleep 5 ; reboot`,
-					},
-					{
-						ID:    "reboot-status",
-						X:     "30",
-						DY:    "2em",
-						Value: "Reboot in 5 sec...",
-					},
-				},
-			},
-		},
-		Style: Style{
-			Value: `
+	return fmt.Sprintf(`
         * {
             font-family: 'JetBrains Mono', monospace;
         }
 
         .bg {
-            fill: #11111b;
+            fill: %s;
             filter: drop-shadow(5px 5px 10px rgba(0, 0, 0, 0.5));
         }
 
         #text-1 {
-            fill: #f38ba8;
+            fill: %s;
         }
 
         #text-2,
         #text-3 {
-            fill: #f5c2e7;
+            fill: %s;
         }
 
         .text {
             font-size: 17px;
-            fill: #cdd6f4;
+            fill: %s;
         }
 
         .text tspan {
-            fill: #9399b2;
+            fill: %s;
         }
 
         .command {
-            fill: #a6e3a1 !important;
+            fill: %s !important;
         }
 
         .str-command {
-            fill: #fab387 !important;
+            fill: %s !important;
         }
 
         .art {
             font-size: 15px;
-            fill: #89b4fa;
+            fill: %s;
         }
 
         .profile {
             font-size: 17px;
-            fill: #89dceb;
+            fill: %s;
         }
-        
+
         #reboot-command, #reboot-status {
             display: none !important;
         }
             `,
+		fill("bg"), fill("text-1"), fill("text-2-3"), fill("text"),
+		fill("text-tspan"), fill("command"), fill("str-command"),
+		fill("art"), fill("profile"))
+}
+
+func (sg *SVGGenerator) Generate() (*SVG, error) {
+	currentTime := sg.now().Format("Mon Jan 02 15:04:05 2006 on tty1")
+	username := sg.cfg.User.Username
+	bg := sg.cfg.Layout.Panels["bg"]
+	artPanel := sg.cfg.Layout.Panels["art"]
+	profilePanel := sg.cfg.Layout.Panels["profile"]
+
+	texts := []Text{
+		{
+			ID:    "text-1",
+			Class: "text",
+			X:     "30",
+			Y:     "40",
+			Value: "Arch Linux 6.7.1-arch1-1 (tty1)",
+		},
+		{
+			ID:    "text-2",
+			Class: "text",
+			X:     "30",
+			Y:     "80",
+			Value: "github.com login: ",
+			Tspan: []Tspan{
+				{
+					ID:    "login-username",
+					Class: "login",
+					Value: username,
+				},
+			},
+		},
+		{
+			ID:    "text-3",
+			Class: "text",
+			X:     "30",
+			Y:     "110",
+			Value: "password: ",
+			Tspan: []Tspan{
+				{
+					ID:    "password",
+					Class: "password",
+					Value: "******",
+				},
+			},
+		},
+		{
+			ID:    "text-4",
+			Class: "text",
+			X:     "30",
+			Y:     "140",
+			Value: "Last login: ",
+			Tspan: []Tspan{
+				{
+					ID:    "last-login",
+					Class: "last-login",
+					Value: currentTime,
+				},
+			},
+		},
+		{
+			ID:    "text-5",
+			Class: "text",
+			X:     "30",
+			Y:     "190",
+			Value: "[" + username + "@github ~]$ ",
+			Tspan: []Tspan{
+				{
+					ID:    "whoami",
+					Class: "command",
+					Value: "./whoami.sh",
+				},
+			},
 		},
 	}
 
-	return svg
+	if sg.cfg.Art.enabled() {
+		texts = append(texts, Text{
+			ID:    "art",
+			Class: "art",
+			X:     artPanel.X,
+			Y:     artPanel.Y,
+			Tspan: sg.generateAsciiArt(),
+		})
+	}
+
+	texts = append(texts,
+		Text{
+			ID:    "profile-info",
+			Class: "profile",
+			X:     profilePanel.X,
+			Y:     profilePanel.Y,
+			Tspan: []Tspan{
+				{
+					ID:    "profile-username",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: username,
+				},
+				{
+					ID:    "profile-separator",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: "-----------------------",
+				},
+				{
+					ID:    "user-bio",
+					X:     profilePanel.X,
+					DY:    "2.3em",
+					Value: "Bio: " + sg.api.GetBio(),
+				},
+				{
+					ID:    "followers",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: fmt.Sprintf("Followers: %d", sg.api.stats.User.Followers),
+				},
+				{
+					ID:    "profile-following",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: fmt.Sprintf("Following: %d", sg.api.stats.User.Following),
+				},
+				{
+					ID:    "total-repo",
+					X:     profilePanel.X,
+					DY:    "2.3em",
+					Value: fmt.Sprintf("Total Repo: %d", sg.api.stats.User.PublicRepos),
+				},
+				{
+					ID:    "total-stars",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: fmt.Sprintf("Total Stars: %d", sg.api.stats.Stars),
+				},
+				{
+					ID:    "total-commits",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: fmt.Sprintf("Total Commits: %d", sg.api.stats.TotalCommits),
+				},
+				{
+					ID:    "most-used-language",
+					X:     profilePanel.X,
+					DY:    "1.3em",
+					Value: "Most used language: " + sg.api.stats.MostUsedLanguages,
+				},
+			},
+		},
+		Text{
+			ID:    "reboot-message",
+			Class: "text",
+			X:     "30",
+			Y:     "550",
+			Value: "[" + username + "@github ~]$ ",
+			Tspan: []Tspan{
+				{
+					ID:    "reboot-command",
+					Class: "reboot-command",
+					Value: `echo "Reboot in 5 sec..." ; sleep 5 ; reboot`,
+				},
+				{
+					ID:    "reboot-status",
+					X:     "30",
+					DY:    "2em",
+					Value: "Reboot in 5 sec...",
+				},
+			},
+		},
+	)
+
+	var images []Image
+	if sg.cfg.Avatar.Enabled {
+		avatarPanel := sg.cfg.Layout.Panels["avatar"]
+		data, err := fetchAvatarBase64(sg.api.client, sg.api.stats.User.AvatarURL, sg.cfg.Avatar.Size)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch avatar: %w", err)
+		}
+		images = append(images, Image{
+			ID:     "avatar",
+			X:      avatarPanel.X,
+			Y:      avatarPanel.Y,
+			Width:  avatarPanel.Width,
+			Height: avatarPanel.Height,
+			Href:   "data:image/png;base64," + data,
+		})
+	}
+
+	svg := &SVG{
+		Xmlns:      "http://www.w3.org/2000/svg",
+		Width:      sg.cfg.Layout.Width,
+		Height:     sg.cfg.Layout.Height,
+		ViewBox:    sg.cfg.Layout.ViewBox,
+		PreserveAR: "xMidYMid",
+		Background: Rect{
+			ID:     "bg-rect",
+			Class:  "bg",
+			Width:  bg.Width,
+			Height: bg.Height,
+			RX:     "20",
+			RY:     "20",
+			X:      bg.X,
+			Y:      bg.Y,
+		},
+		Texts:  texts,
+		Images: images,
+		Style: Style{
+			Value: buildStyle(sg.cfg.Theme),
+		},
+	}
+
+	return svg, nil
 }
 
 func (sg *SVGGenerator) SaveToFile(filename string) error {
-	svg := sg.Generate()
+	svg, err := sg.Generate()
+	if err != nil {
+		return err
+	}
 
 	output, err := xml.MarshalIndent(svg, "", "    ")
 	if err != nil {
@@ -539,10 +389,35 @@ func (sg *SVGGenerator) SaveToFile(filename string) error {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		if err := runServe(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	configPath := flag.String("config", defaultConfigPath, "path to config.yaml")
+	theme := flag.String("theme", "", "built-in theme name, overrides the config file's theme")
+	flag.Parse()
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(*configPath); err == nil {
+		cfg, err = LoadConfig(*configPath)
+		if err != nil {
+			log.Fatal("Failed to load config:", err)
+		}
+	}
+
+	if *theme != "" {
+		if err := cfg.ApplyTheme(*theme); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	log.Println("Starting GitHub Profile README Generator...")
 
 	// Initialize API manager and fetch data
-	apiManager := NewAPIManager()
+	apiManager := NewAPIManager(cfg, nil)
 	if err := apiManager.Setup(); err != nil {
 		log.Fatal("Failed to setup API manager:", err)
 	}
@@ -550,12 +425,12 @@ func main() {
 	log.Println("Generating SVG...")
 
 	// Generate and save SVG
-	svgGenerator := NewSVGGenerator(apiManager)
-	if err := svgGenerator.SaveToFile(FILE_NAME); err != nil {
+	svgGenerator := NewSVGGenerator(apiManager, cfg)
+	if err := svgGenerator.SaveToFile(cfg.Output.FileName); err != nil {
 		log.Fatal("Failed to generate SVG file:", err)
 	}
 
-	log.Println("Successfully generated", FILE_NAME)
+	log.Println("Successfully generated", cfg.Output.FileName)
 
 	// Print summary
 	fmt.Println("\n=== GitHub Profile Stats ===")
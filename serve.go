@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// sseBroker fans out a "reload" message to every browser connected to
+// /events whenever the SVG is regenerated.
+type sseBroker struct {
+	mu      sync.Mutex
+	clients map[chan string]struct{}
+}
+
+func newSSEBroker() *sseBroker {
+	return &sseBroker{clients: make(map[chan string]struct{})}
+}
+
+func (b *sseBroker) subscribe() chan string {
+	ch := make(chan string, 1)
+	b.mu.Lock()
+	b.clients[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *sseBroker) unsubscribe(ch chan string) {
+	b.mu.Lock()
+	delete(b.clients, ch)
+	b.mu.Unlock()
+	close(ch)
+}
+
+func (b *sseBroker) broadcast(event string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.clients {
+		select {
+		case ch <- event:
+		default:
+			// client is behind, drop the event rather than block generation
+		}
+	}
+}
+
+// devServer holds the state a running `serve` session needs: the current
+// config, the last rendered SVG, and the broker used to tell connected
+// browsers to reload.
+type devServer struct {
+	mu     sync.RWMutex
+	cfg    *Config
+	dev    bool
+	svg    []byte
+	broker *sseBroker
+}
+
+func newDevServer(cfg *Config, dev bool) *devServer {
+	return &devServer{cfg: cfg, dev: dev, broker: newSSEBroker()}
+}
+
+// regenerate re-fetches (or, under -dev, restubs) GitHub data and
+// re-renders the SVG, then broadcasts a reload to connected browsers.
+func (s *devServer) regenerate() error {
+	s.mu.RLock()
+	cfg := s.cfg
+	s.mu.RUnlock()
+
+	apiManager := NewAPIManager(cfg, nil)
+
+	if s.dev {
+		if err := loadDevFixtures(apiManager); err != nil {
+			return fmt.Errorf("failed to load dev fixtures: %w", err)
+		}
+	} else if err := apiManager.Setup(); err != nil {
+		return fmt.Errorf("failed to fetch GitHub data: %w", err)
+	}
+
+	svg, err := NewSVGGenerator(apiManager, cfg).Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate SVG: %w", err)
+	}
+
+	output, err := xml.MarshalIndent(svg, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal SVG: %w", err)
+	}
+
+	s.mu.Lock()
+	s.svg = append([]byte(`<?xml version="1.0" ?>`), output...)
+	s.mu.Unlock()
+
+	s.broker.broadcast("reload")
+	return nil
+}
+
+func (s *devServer) currentSVG() []byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.svg
+}
+
+func (s *devServer) handleSVG(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Write(s.currentSVG())
+}
+
+const servePageTemplate = `<!DOCTYPE html>
+<html>
+<head><title>shv-ng dev</title></head>
+<body style="margin:0;background:#1e1e2e;display:flex;justify-content:center;align-items:center;height:100vh;">
+  <img id="terminal" src="/terminal.svg" alt="terminal.svg">
+  <script>
+    const img = document.getElementById("terminal");
+    const events = new EventSource("/events");
+    events.onmessage = (e) => {
+      if (e.data === "reload") {
+        img.src = "/terminal.svg?t=" + Date.now();
+      }
+    };
+  </script>
+</body>
+</html>`
+
+func (s *devServer) handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprint(w, servePageTemplate)
+}
+
+func (s *devServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ch := s.broker.subscribe()
+	defer s.broker.unsubscribe(ch)
+
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", event)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func (s *devServer) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	if err := s.regenerate(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// watchConfig regenerates whenever the config file (or a themes file
+// alongside it) changes on disk, so editing config.yaml live-reloads the
+// browser without restarting the server.
+func watchConfig(ctx context.Context, path string, s *devServer) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start config watcher: %w", err)
+	}
+
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		return fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				cfg, err := LoadConfig(path)
+				if err != nil {
+					log.Printf("Warning: failed to reload config: %v", err)
+					continue
+				}
+				s.mu.Lock()
+				s.cfg = cfg
+				s.mu.Unlock()
+				if err := s.regenerate(); err != nil {
+					log.Printf("Warning: failed to regenerate after config change: %v", err)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("Warning: config watcher error: %v", err)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// runServe implements `shv-ng serve`: a small HTTP server that renders
+// the SVG on demand and pushes live-reload events to the browser.
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configPath := fs.String("config", defaultConfigPath, "path to config.yaml")
+	addr := fs.String("addr", ":8080", "address to listen on")
+	dev := fs.Bool("dev", false, "stub GitHub API calls with cached fixtures instead of hitting the network")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(*configPath); err == nil {
+		cfg, err = LoadConfig(*configPath)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+	}
+
+	server := newDevServer(cfg, *dev)
+	if err := server.regenerate(); err != nil {
+		return fmt.Errorf("failed initial generation: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := watchConfig(ctx, *configPath, server); err != nil {
+		log.Printf("Warning: %v (config changes won't trigger a reload)", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", server.handleIndex)
+	mux.HandleFunc("/terminal.svg", server.handleSVG)
+	mux.HandleFunc("/events", server.handleEvents)
+	mux.HandleFunc("/refresh", server.handleRefresh)
+
+	httpServer := &http.Server{Addr: *addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Warning: error during shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("Serving /terminal.svg on %s (dev=%v)", *addr, *dev)
+	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("server error: %w", err)
+	}
+
+	return nil
+}
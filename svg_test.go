@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+const goldenSVGPath = "testdata/terminal.golden.svg"
+
+// TestSVGGenerator_Generate_Golden renders the SVG from a fixed, fully
+// stubbed APIManager (no network, no wall-clock) and compares it against
+// testdata/terminal.golden.svg. Run with -update to regenerate the golden
+// file after an intentional rendering change.
+func TestSVGGenerator_Generate_Golden(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Art.Enabled = boolPtr(false)
+
+	api := NewAPIManager(cfg, &http.Client{})
+	api.stats = &GitHubStats{
+		User: &GitHubUser{
+			Login:       "shv-ng",
+			Followers:   42,
+			Following:   7,
+			Bio:         "Building shv-ng",
+			PublicRepos: 13,
+		},
+		Stars:             128,
+		TotalCommits:      613,
+		MostUsedLanguages: "Go, Python, Lua",
+	}
+
+	sg := NewSVGGenerator(api, cfg)
+	sg.now = func() time.Time {
+		return time.Date(2026, time.July, 27, 12, 0, 0, 0, time.UTC)
+	}
+
+	svg, err := sg.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	output, err := xml.MarshalIndent(svg, "", "    ")
+	if err != nil {
+		t.Fatalf("MarshalIndent() error = %v", err)
+	}
+	got := append([]byte(`<?xml version="1.0" ?>`), output...)
+
+	if *update {
+		if err := os.WriteFile(goldenSVGPath, got, 0644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(goldenSVGPath)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("generated SVG does not match %s; rerun with -update if this is intentional", goldenSVGPath)
+	}
+}
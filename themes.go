@@ -0,0 +1,91 @@
+package main
+
+// builtinThemes are the palettes shippable via -theme without editing
+// config.yaml. Each maps the SVG ids/classes used in Generate() to a
+// fill color; see config.yaml.example for the full key list.
+var builtinThemes = map[string]ThemeConfig{
+	"catppuccin-mocha": {
+		Name: "catppuccin-mocha",
+		Fills: map[string]string{
+			"bg":          "#11111b",
+			"text-1":      "#f38ba8",
+			"text-2-3":    "#f5c2e7",
+			"text":        "#cdd6f4",
+			"text-tspan":  "#9399b2",
+			"command":     "#a6e3a1",
+			"str-command": "#fab387",
+			"art":         "#89b4fa",
+			"profile":     "#89dceb",
+		},
+	},
+	"catppuccin-latte": {
+		Name: "catppuccin-latte",
+		Fills: map[string]string{
+			"bg":          "#eff1f5",
+			"text-1":      "#d20f39",
+			"text-2-3":    "#ea76cb",
+			"text":        "#4c4f69",
+			"text-tspan":  "#6c6f85",
+			"command":     "#40a02b",
+			"str-command": "#fe640b",
+			"art":         "#1e66f5",
+			"profile":     "#04a5e5",
+		},
+	},
+	"dracula": {
+		Name: "dracula",
+		Fills: map[string]string{
+			"bg":          "#282a36",
+			"text-1":      "#ff5555",
+			"text-2-3":    "#ff79c6",
+			"text":        "#f8f8f2",
+			"text-tspan":  "#6272a4",
+			"command":     "#50fa7b",
+			"str-command": "#ffb86c",
+			"art":         "#bd93f9",
+			"profile":     "#8be9fd",
+		},
+	},
+	"nord": {
+		Name: "nord",
+		Fills: map[string]string{
+			"bg":          "#2e3440",
+			"text-1":      "#bf616a",
+			"text-2-3":    "#b48ead",
+			"text":        "#d8dee9",
+			"text-tspan":  "#4c566a",
+			"command":     "#a3be8c",
+			"str-command": "#d08770",
+			"art":         "#81a1c1",
+			"profile":     "#88c0d0",
+		},
+	},
+	"gruvbox": {
+		Name: "gruvbox",
+		Fills: map[string]string{
+			"bg":          "#282828",
+			"text-1":      "#fb4934",
+			"text-2-3":    "#d3869b",
+			"text":        "#ebdbb2",
+			"text-tspan":  "#928374",
+			"command":     "#b8bb26",
+			"str-command": "#fe8019",
+			"art":         "#83a598",
+			"profile":     "#8ec07c",
+		},
+	},
+	"tokyo-night": {
+		Name: "tokyo-night",
+		Fills: map[string]string{
+			"bg":          "#1a1b26",
+			"text-1":      "#f7768e",
+			"text-2-3":    "#bb9af7",
+			"text":        "#c0caf5",
+			"text-tspan":  "#565f89",
+			"command":     "#9ece6a",
+			"str-command": "#ff9e64",
+			"art":         "#7aa2f7",
+			"profile":     "#7dcfff",
+		},
+	},
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	maxRetryAttempts  = 5
+	initialBackoff    = time.Second
+	maxBackoff        = 60 * time.Second
+	maxRateLimitSleep = 15 * time.Minute
+
+	// githubAPIHost is the only host the token is attached to. The same
+	// client also fetches the avatar and star count from third-party
+	// hosts, which must never see GITHUB_TOKEN.
+	githubAPIHost = "api.github.com"
+)
+
+// githubTransport wraps the default RoundTripper to authenticate
+// requests with GITHUB_TOKEN, sleep out rate-limit exhaustion, retry
+// transient 5xx/abuse-detection responses with backoff, and replay
+// cached bodies on 304 Not Modified.
+type githubTransport struct {
+	next  http.RoundTripper
+	token string
+	cache *diskCache
+}
+
+func newGitHubTransport(token string) *githubTransport {
+	return &githubTransport{
+		next:  http.DefaultTransport,
+		token: token,
+		cache: newDiskCache(),
+	}
+}
+
+func (t *githubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token != "" && req.URL.Host == githubAPIHost {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+
+	key := cacheKeyFor(req)
+	if etag, ok := t.cache.etag(key); ok {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	var resp *http.Response
+	backoff := initialBackoff
+
+	for attempt := 1; attempt <= maxRetryAttempts; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			rewound, err := rewindRequest(req)
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = rewound
+		}
+
+		var err error
+		resp, err = t.next.RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusNotModified {
+			body, ok := t.cache.body(key)
+			resp.Body.Close()
+			if !ok {
+				return nil, fmt.Errorf("received 304 for %s with no cached body", req.URL)
+			}
+			return cachedResponse(resp, body), nil
+		}
+
+		if resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			resp.Body.Close()
+			if err := sleepUntilRateLimitReset(resp); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= 500 || isAbuseDetection(resp) {
+			resp.Body.Close()
+			if attempt == maxRetryAttempts {
+				return nil, fmt.Errorf("request to %s failed after %d attempts: status %d", req.URL, maxRetryAttempts, resp.StatusCode)
+			}
+			time.Sleep(jitter(backoff))
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+
+		break
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			t.cache.store(key, etag, body)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return resp, nil
+}
+
+// isAbuseDetection recognizes GitHub's secondary rate limit response: a
+// 403 carrying a Retry-After header, distinct from the primary
+// X-RateLimit-Remaining exhaustion case.
+func isAbuseDetection(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusForbidden && resp.Header.Get("Retry-After") != ""
+}
+
+func sleepUntilRateLimitReset(resp *http.Response) error {
+	resetHeader := resp.Header.Get("X-RateLimit-Reset")
+	if resetHeader == "" {
+		return fmt.Errorf("rate limit exhausted with no X-RateLimit-Reset header")
+	}
+
+	resetUnix, err := strconv.ParseInt(resetHeader, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse X-RateLimit-Reset: %w", err)
+	}
+
+	wait := time.Until(time.Unix(resetUnix, 0))
+	if wait <= 0 {
+		return nil
+	}
+	if wait > maxRateLimitSleep {
+		return fmt.Errorf("rate limit resets in %s, aborting instead of sleeping", wait.Round(time.Second))
+	}
+
+	log.Printf("Rate limit exhausted, sleeping %s until reset", wait.Round(time.Second))
+	time.Sleep(wait)
+	return nil
+}
+
+// jitter randomizes a backoff duration to [d/2, 3d/2) so retrying
+// clients don't all wake up at once.
+func jitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
+
+// rewindRequest clones a request for a retry, using GetBody to replay a
+// POST body (GraphQL queries) that the first attempt already consumed.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// cacheKeyFor identifies a request for ETag caching purposes. The body
+// is folded in so that distinct GraphQL queries/variables posted to the
+// same URL don't collide.
+func cacheKeyFor(req *http.Request) string {
+	key := req.Method + " " + req.URL.String()
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			sum := sha256.Sum256(data)
+			key += " " + hex.EncodeToString(sum[:])
+		}
+	}
+
+	return key
+}
+
+func cachedResponse(resp *http.Response, body []byte) *http.Response {
+	resp.StatusCode = http.StatusOK
+	resp.Status = "200 OK (cached)"
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp
+}
+
+// diskCache persists ETag/body pairs under ~/.cache/shv-ng/http so repeat
+// runs can send conditional requests instead of re-downloading unchanged
+// responses.
+type diskCache struct {
+	dir string
+}
+
+func newDiskCache() *diskCache {
+	dir, err := httpCacheDir()
+	if err != nil {
+		log.Printf("Warning: HTTP cache disabled: %v", err)
+		return &diskCache{}
+	}
+	return &diskCache{dir: dir}
+}
+
+func httpCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+
+	dir := filepath.Join(home, ".cache", "shv-ng", "http")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cache dir %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+func (c *diskCache) paths(key string) (etagPath, bodyPath string) {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(c.dir, name+".etag"), filepath.Join(c.dir, name+".body")
+}
+
+func (c *diskCache) etag(key string) (string, bool) {
+	if c.dir == "" {
+		return "", false
+	}
+	etagPath, _ := c.paths(key)
+	data, err := os.ReadFile(etagPath)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+func (c *diskCache) body(key string) ([]byte, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	_, bodyPath := c.paths(key)
+	data, err := os.ReadFile(bodyPath)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func (c *diskCache) store(key, etag string, body []byte) {
+	if c.dir == "" {
+		return
+	}
+	etagPath, bodyPath := c.paths(key)
+	if err := os.WriteFile(etagPath, []byte(etag), 0644); err != nil {
+		log.Printf("Warning: failed to cache ETag: %v", err)
+		return
+	}
+	if err := os.WriteFile(bodyPath, body, 0644); err != nil {
+		log.Printf("Warning: failed to cache response body: %v", err)
+	}
+}